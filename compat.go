@@ -0,0 +1,101 @@
+package semantic
+
+import "fmt"
+
+// The functions below operate directly on raw semver strings, mirroring the
+// surface of golang.org/x/mod/semver. They let this package be dropped in as
+// a replacement for x/mod/semver while retaining the stricter regex
+// validation Parse already enforces: invalid input returns the zero value
+// for the return type ("", false, or 0) rather than an error.
+//
+// Internally they delegate to ParseLoose, so shorthand versions like "v1"
+// and "v1.2" are accepted, the same as x/mod/semver.
+
+// Compare returns an integer comparing two versions according to semver
+// precedence. The result will be 0 if a == b, -1 if a < b, or +1 if a > b.
+//
+// An invalid semantic version string is considered less than a valid one.
+// All invalid semantic version strings compare equal to each other.
+func Compare(a, b string) int {
+	ta, aOK := ParseLoose(a)
+	tb, bOK := ParseLoose(b)
+
+	switch {
+	case !aOK && !bOK:
+		return 0
+	case !aOK:
+		return -1
+	case !bOK:
+		return 1
+	case ta.Less(tb):
+		return -1
+	case tb.Less(ta):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// IsValid reports whether s is a valid semantic version string.
+func IsValid(s string) bool {
+	_, ok := ParseLoose(s)
+	return ok
+}
+
+// Canonical returns the canonical formatting of the semantic version s,
+// filling in any missing .MINOR or .PATCH and discarding build metadata.
+// Canonical returns the empty string if s is not a valid semantic version
+// string.
+func Canonical(s string) string {
+	t, ok := ParseLoose(s)
+	if !ok {
+		return ""
+	}
+	return t.WithBuildMetadata("").String()
+}
+
+// Major returns the major version prefix of the semantic version s. For
+// example, Major("v2.1.0") == "v2". Major returns the empty string if s is
+// not a valid semantic version string.
+func Major(s string) string {
+	t, ok := ParseLoose(s)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("v%d", t.Major)
+}
+
+// MajorMinor returns the major.minor version prefix of the semantic version
+// s. For example, MajorMinor("v2.1.0") == "v2.1". MajorMinor returns the
+// empty string if s is not a valid semantic version string.
+func MajorMinor(s string) string {
+	t, ok := ParseLoose(s)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("v%d.%d", t.Major, t.Minor)
+}
+
+// PreRelease returns the pre-release suffix of the semantic version s. For
+// example, PreRelease("v2.1.0-pre+meta") == "-pre". PreRelease returns the
+// empty string if s is not a valid semantic version string or has no
+// pre-release suffix.
+func PreRelease(s string) string {
+	t, ok := ParseLoose(s)
+	if !ok || t.PreRelease == "" {
+		return ""
+	}
+	return "-" + t.PreRelease
+}
+
+// Build returns the build metadata suffix of the semantic version s. For
+// example, Build("v2.1.0-pre+meta") == "+meta". Build returns the empty
+// string if s is not a valid semantic version string or has no build
+// metadata suffix.
+func Build(s string) string {
+	t, ok := ParseLoose(s)
+	if !ok || t.BuildMetadata == "" {
+		return ""
+	}
+	return "+" + t.BuildMetadata
+}
@@ -0,0 +1,57 @@
+package semantic
+
+import (
+	"testing"
+
+	"github.com/shoenig/test/must"
+)
+
+func Test_Compare(t *testing.T) {
+	must.Eq(t, 0, Compare("v1.2.3", "v1.2.3"))
+	must.Eq(t, -1, Compare("v1.2.3", "v1.2.4"))
+	must.Eq(t, 1, Compare("v1.2.4", "v1.2.3"))
+	must.Eq(t, 0, Compare("v1.2.3+linux", "v1.2.3+darwin")) // build metadata ignored
+	must.Eq(t, -1, Compare("v1.2.3-alpha", "v1.2.3"))
+
+	must.Eq(t, -1, Compare("not-valid", "v1.2.3"))
+	must.Eq(t, 1, Compare("v1.2.3", "not-valid"))
+	must.Eq(t, 0, Compare("not-valid", "also-not-valid"))
+}
+
+func Test_IsValid(t *testing.T) {
+	must.True(t, IsValid("v1.2.3"))
+	must.True(t, IsValid("v1.2"))
+	must.True(t, IsValid("v1"))
+	must.False(t, IsValid("1.2.3"))
+	must.False(t, IsValid("not-valid"))
+}
+
+func Test_Canonical(t *testing.T) {
+	must.Eq(t, "v1.2.3", Canonical("v1.2.3"))
+	must.Eq(t, "v1.2.0", Canonical("v1.2"))
+	must.Eq(t, "v1.0.0", Canonical("v1"))
+	must.Eq(t, "v1.2.3-alpha", Canonical("v1.2.3-alpha+linux"))
+	must.Eq(t, "", Canonical("not-valid"))
+}
+
+func Test_Major(t *testing.T) {
+	must.Eq(t, "v1", Major("v1.2.3"))
+	must.Eq(t, "", Major("not-valid"))
+}
+
+func Test_MajorMinor(t *testing.T) {
+	must.Eq(t, "v1.2", MajorMinor("v1.2.3"))
+	must.Eq(t, "", MajorMinor("not-valid"))
+}
+
+func Test_PreRelease(t *testing.T) {
+	must.Eq(t, "-pre", PreRelease("v2.1.0-pre+meta"))
+	must.Eq(t, "", PreRelease("v2.1.0"))
+	must.Eq(t, "", PreRelease("not-valid"))
+}
+
+func Test_Build(t *testing.T) {
+	must.Eq(t, "+meta", Build("v2.1.0-pre+meta"))
+	must.Eq(t, "", Build("v2.1.0"))
+	must.Eq(t, "", Build("not-valid"))
+}
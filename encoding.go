@@ -0,0 +1,91 @@
+package semantic
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+)
+
+var (
+	_ encoding.TextMarshaler   = Tag{}
+	_ encoding.TextUnmarshaler = (*Tag)(nil)
+	_ json.Marshaler           = Tag{}
+	_ json.Unmarshaler         = (*Tag)(nil)
+	_ sql.Scanner              = (*Tag)(nil)
+	_ driver.Valuer            = Tag{}
+)
+
+// MarshalText implements encoding.TextMarshaler, encoding t as its canonical
+// string form, e.g. "v1.2.3-pre+build".
+func (t Tag) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing text with Parse.
+func (t *Tag) UnmarshalText(text []byte) error {
+	tag, ok := Parse(string(text))
+	if !ok {
+		return fmt.Errorf("semantic: invalid tag %q", string(text))
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding t as a JSON string
+// containing its canonical form, e.g. "v1.2.3-pre+build".
+func (t Tag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, parsing the JSON string with
+// Parse.
+func (t *Tag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("semantic: invalid tag json %s: %w", data, err)
+	}
+
+	tag, ok := Parse(s)
+	if !ok {
+		return fmt.Errorf("semantic: invalid tag %q", s)
+	}
+	*t = tag
+	return nil
+}
+
+// Scan implements sql.Scanner, accepting a string or []byte column value and
+// parsing it with Parse. A nil value scans as the zero Tag.
+func (t *Tag) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*t = empty
+		return nil
+	case string:
+		tag, ok := Parse(v)
+		if !ok {
+			return fmt.Errorf("semantic: invalid tag %q", v)
+		}
+		*t = tag
+		return nil
+	case []byte:
+		tag, ok := Parse(string(v))
+		if !ok {
+			return fmt.Errorf("semantic: invalid tag %q", string(v))
+		}
+		*t = tag
+		return nil
+	default:
+		return fmt.Errorf("semantic: cannot scan %T into Tag", value)
+	}
+}
+
+// Value implements driver.Valuer, returning the canonical string form of t,
+// or nil if t is the zero Tag.
+func (t Tag) Value() (driver.Value, error) {
+	if t == empty {
+		return nil, nil
+	}
+	return t.String(), nil
+}
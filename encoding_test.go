@@ -0,0 +1,63 @@
+package semantic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/shoenig/test/must"
+)
+
+func Test_Tag_TextMarshal(t *testing.T) {
+	tag := New3(1, 2, 3, "alpha", "linux")
+
+	text, err := tag.MarshalText()
+	must.NoError(t, err)
+	must.Eq(t, "v1.2.3-alpha+linux", string(text))
+
+	var result Tag
+	must.NoError(t, result.UnmarshalText(text))
+	must.Eq(t, tag, result)
+
+	must.Error(t, (&Tag{}).UnmarshalText([]byte("not-a-tag")))
+}
+
+func Test_Tag_JSON(t *testing.T) {
+	tag := New2(1, 2, 3, "rc1")
+
+	b, err := json.Marshal(tag)
+	must.NoError(t, err)
+	must.Eq(t, `"v1.2.3-rc1"`, string(b))
+
+	var result Tag
+	must.NoError(t, json.Unmarshal(b, &result))
+	must.Eq(t, tag, result)
+
+	must.Error(t, json.Unmarshal([]byte(`"not-a-tag"`), &Tag{}))
+	must.Error(t, json.Unmarshal([]byte(`123`), &Tag{}))
+}
+
+func Test_Tag_Scan(t *testing.T) {
+	var tag Tag
+
+	must.NoError(t, tag.Scan("v1.2.3"))
+	must.Eq(t, New(1, 2, 3), tag)
+
+	must.NoError(t, tag.Scan([]byte("v2.0.0")))
+	must.Eq(t, New(2, 0, 0), tag)
+
+	must.NoError(t, tag.Scan(nil))
+	must.Eq(t, empty, tag)
+
+	must.Error(t, tag.Scan("not-a-tag"))
+	must.Error(t, tag.Scan(123))
+}
+
+func Test_Tag_Value(t *testing.T) {
+	value, err := New(1, 2, 3).Value()
+	must.NoError(t, err)
+	must.Eq(t, "v1.2.3", value)
+
+	value, err = Tag{}.Value()
+	must.NoError(t, err)
+	must.Eq(t, nil, value)
+}
@@ -0,0 +1,323 @@
+package semantic
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/shoenig/regexplus"
+)
+
+// operator is one of the comparison operators usable in a Range comparator.
+type operator int
+
+const (
+	opEQ operator = iota
+	opNE
+	opLT
+	opLE
+	opGT
+	opGE
+)
+
+func (op operator) String() string {
+	switch op {
+	case opEQ:
+		return "="
+	case opNE:
+		return "!="
+	case opLT:
+		return "<"
+	case opLE:
+		return "<="
+	case opGT:
+		return ">"
+	case opGE:
+		return ">="
+	default:
+		panic("bug: unknown operator")
+	}
+}
+
+// comparator is a single operator plus Tag boundary, e.g. ">=1.2.0".
+type comparator struct {
+	op  operator
+	tag Tag
+}
+
+func (c comparator) matches(t Tag) bool {
+	switch c.op {
+	case opEQ:
+		return t.Equal(c.tag)
+	case opNE:
+		return !t.Equal(c.tag)
+	case opLT:
+		return t.Less(c.tag)
+	case opLE:
+		return t.Less(c.tag) || t.Equal(c.tag)
+	case opGT:
+		return !t.Less(c.tag) && !t.Equal(c.tag)
+	case opGE:
+		return !t.Less(c.tag)
+	default:
+		panic("bug: unknown operator")
+	}
+}
+
+func (c comparator) String() string {
+	return c.op.String() + c.tag.String()
+}
+
+// andGroup is a set of comparators that must all match (an AND-group).
+type andGroup []comparator
+
+func (g andGroup) String() string {
+	parts := make([]string, len(g))
+	for i, c := range g {
+		parts[i] = c.String()
+	}
+	return strings.Join(parts, " ")
+}
+
+// Range represents a semver constraint expression, e.g. ">=1.2.0 <2.0.0" or
+// "^1.2.3 || ~2.3.4". It is composed of one or more AND-groups of comparators,
+// any one of which may match for the Range as a whole to match (an OR of ANDs).
+type Range struct {
+	groups []andGroup
+}
+
+// ParseRange parses a semver constraint expression into a Range.
+//
+// The grammar accepts whitespace-separated comparators (forming an AND-group),
+// with "||" separating alternative AND-groups (an OR-group). Each comparator is
+// one of "=", "!=", "<", "<=", ">", ">=" followed by a version, or a caret
+// ("^1.2.3"), tilde ("~1.2"), or x-range ("1.x", "1.2.x") short form, which is
+// expanded into a pair of comparators at parse time.
+//
+// Examples:
+//
+//	">=1.2.0 <2.0.0"
+//	"^1.2.3"
+//	"~1.2"
+//	"1.x"
+//	">=1.0.0 <2.0.0 || >=3.0.0"
+func ParseRange(expr string) (Range, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return Range{}, fmt.Errorf("semantic: empty range expression")
+	}
+
+	orParts := strings.Split(expr, "||")
+	groups := make([]andGroup, 0, len(orParts))
+	for _, orPart := range orParts {
+		fields := strings.Fields(orPart)
+		if len(fields) == 0 {
+			return Range{}, fmt.Errorf("semantic: empty comparator group in range %q", expr)
+		}
+
+		group := make(andGroup, 0, len(fields))
+		for _, field := range fields {
+			comparators, err := expandToken(field)
+			if err != nil {
+				return Range{}, fmt.Errorf("semantic: parse range %q: %w", expr, err)
+			}
+			group = append(group, comparators...)
+		}
+		groups = append(groups, group)
+	}
+
+	return Range{groups: groups}, nil
+}
+
+var rangeTokenRe = regexp.MustCompile(
+	`^(?P<op>=|!=|<=|>=|<|>|\^|~)?v?` +
+		`(?P<major>\d+|[xX*])` +
+		`(?:\.(?P<minor>\d+|[xX*]))?` +
+		`(?:\.(?P<patch>\d+|[xX*]))?` +
+		`(?:-(?P<pr>[0-9A-Za-z.-]+))?` +
+		`(?:\+(?P<bm>[0-9A-Za-z.-]+))?$`,
+)
+
+func expandToken(token string) ([]comparator, error) {
+	matches := regexplus.FindNamedSubmatches(rangeTokenRe, token)
+
+	majorStr, exists := matches["major"]
+	if !exists {
+		return nil, fmt.Errorf("invalid comparator %q", token)
+	}
+
+	op := matches["op"]
+	minorStr := matches["minor"]
+	patchStr := matches["patch"]
+	pr := matches["pr"]
+	bm := matches["bm"]
+
+	majorWild := isWild(majorStr)
+	minorWild := isWild(minorStr)
+	patchWild := isWild(patchStr)
+
+	major := wildNumber(majorStr)
+	minor := wildNumber(minorStr)
+	patch := wildNumber(patchStr)
+
+	switch op {
+	case "^":
+		lower := New3(major, minor, patch, pr, bm)
+		var upper Tag
+		switch {
+		case major > 0:
+			upper = New(major+1, 0, 0)
+		case minorWild:
+			upper = New(1, 0, 0)
+		case minor > 0 || patchWild:
+			upper = New(0, minor+1, 0)
+		default:
+			upper = New(0, 0, patch+1)
+		}
+		return []comparator{{opGE, lower}, {opLT, upper}}, nil
+
+	case "~":
+		lower := New3(major, minor, patch, pr, bm)
+		var upper Tag
+		if minorWild {
+			upper = New(major+1, 0, 0)
+		} else {
+			upper = New(major, minor+1, 0)
+		}
+		return []comparator{{opGE, lower}, {opLT, upper}}, nil
+
+	case "":
+		// a bare wildcard major ("*", "x", "X") matches any version, not just
+		// major 0.x ; represent it as an empty AND-group, which groupMatches
+		// treats as "matches anything" (still subject to the default
+		// pre-release exclusion)
+		if majorWild {
+			return nil, nil
+		}
+		if minorWild {
+			return []comparator{{opGE, New(major, 0, 0)}, {opLT, New(major+1, 0, 0)}}, nil
+		}
+		if patchWild {
+			return []comparator{{opGE, New(major, minor, 0)}, {opLT, New(major, minor+1, 0)}}, nil
+		}
+		return []comparator{{opEQ, New3(major, minor, patch, pr, bm)}}, nil
+
+	default:
+		o, err := parseOperator(op)
+		if err != nil {
+			return nil, err
+		}
+		return []comparator{{o, New3(major, minor, patch, pr, bm)}}, nil
+	}
+}
+
+func isWild(s string) bool {
+	return s == "" || s == "x" || s == "X" || s == "*"
+}
+
+func wildNumber(s string) int {
+	if isWild(s) {
+		return 0
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		panic("bug in our range regexp")
+	}
+	return n
+}
+
+func parseOperator(s string) (operator, error) {
+	switch s {
+	case "=":
+		return opEQ, nil
+	case "!=":
+		return opNE, nil
+	case "<":
+		return opLT, nil
+	case "<=":
+		return opLE, nil
+	case ">":
+		return opGT, nil
+	case ">=":
+		return opGE, nil
+	default:
+		return 0, fmt.Errorf("unknown operator %q", s)
+	}
+}
+
+// Matches reports whether t satisfies the Range.
+//
+// A pre-release Tag only matches if it falls within one of the Range's
+// AND-groups AND that group contains a comparator bound to the same
+// major.minor.patch carrying a pre-release of its own. This mirrors the
+// behavior of npm and blang/semver, and prevents pre-release tags from
+// unexpectedly matching a range meant for final releases.
+func (r Range) Matches(t Tag) bool {
+	for _, group := range r.groups {
+		if groupMatches(group, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func groupMatches(group andGroup, t Tag) bool {
+	for _, c := range group {
+		if !c.matches(t) {
+			return false
+		}
+	}
+
+	if t.PreRelease == "" {
+		return true
+	}
+
+	for _, c := range group {
+		if c.tag.PreRelease != "" &&
+			c.tag.Major == t.Major &&
+			c.tag.Minor == t.Minor &&
+			c.tag.Patch == t.Patch {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String returns the canonical representation of the Range.
+func (r Range) String() string {
+	parts := make([]string, len(r.groups))
+	for i, g := range r.groups {
+		parts[i] = g.String()
+	}
+	return strings.Join(parts, " || ")
+}
+
+// AND combines r and o by joining every AND-group of r with every AND-group
+// of o, such that the result matches only tags that satisfy both r and o.
+// The one exception is pre-release tags: the pre-release rule of Matches is
+// applied to each joined group as a whole, so a comparator carrying a
+// pre-release on one side can admit a pre-release tag that the other side
+// alone rejects.
+func (r Range) AND(o Range) Range {
+	groups := make([]andGroup, 0, len(r.groups)*len(o.groups))
+	for _, g1 := range r.groups {
+		for _, g2 := range o.groups {
+			combined := make(andGroup, 0, len(g1)+len(g2))
+			combined = append(combined, g1...)
+			combined = append(combined, g2...)
+			groups = append(groups, combined)
+		}
+	}
+	return Range{groups: groups}
+}
+
+// OR combines r and o such that the result matches any tag that satisfies
+// either r or o.
+func (r Range) OR(o Range) Range {
+	groups := make([]andGroup, 0, len(r.groups)+len(o.groups))
+	groups = append(groups, r.groups...)
+	groups = append(groups, o.groups...)
+	return Range{groups: groups}
+}
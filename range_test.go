@@ -0,0 +1,133 @@
+package semantic
+
+import (
+	"testing"
+
+	"github.com/shoenig/test/must"
+)
+
+func Test_ParseRange(t *testing.T) {
+	try := func(expr string, tag Tag, exp bool) {
+		r, err := ParseRange(expr)
+		must.NoError(t, err)
+		must.Eq(t, exp, r.Matches(tag))
+	}
+
+	try(">=1.2.0 <2.0.0", New(1, 2, 0), true)
+	try(">=1.2.0 <2.0.0", New(1, 9, 9), true)
+	try(">=1.2.0 <2.0.0", New(2, 0, 0), false)
+	try(">=1.2.0 <2.0.0", New(1, 1, 9), false)
+
+	try("^1.2.3", New(1, 2, 3), true)
+	try("^1.2.3", New(1, 9, 0), true)
+	try("^1.2.3", New(2, 0, 0), false)
+	try("^1.2.3", New(1, 2, 2), false)
+
+	try("^0.2.3", New(0, 2, 3), true)
+	try("^0.2.3", New(0, 2, 9), true)
+	try("^0.2.3", New(0, 3, 0), false)
+
+	try("^0.0.3", New(0, 0, 3), true)
+	try("^0.0.3", New(0, 0, 4), false)
+
+	try("^0.0.x", New(0, 0, 0), true)
+	try("^0.0.x", New(0, 0, 9), true)
+	try("^0.0.x", New(0, 1, 0), false)
+
+	try("^0.0", New(0, 0, 0), true)
+	try("^0.0", New(0, 0, 9), true)
+	try("^0.0", New(0, 1, 0), false)
+
+	try("^0.x", New(0, 0, 0), true)
+	try("^0.x", New(0, 9, 9), true)
+	try("^0.x", New(1, 0, 0), false)
+
+	try("^0", New(0, 9, 9), true)
+	try("^0", New(1, 0, 0), false)
+
+	try("*", New(0, 0, 0), true)
+	try("*", New(5, 0, 0), true)
+	try("*", New(5, 9, 9), true)
+	try("*", New2(1, 0, 0, "alpha"), false) // pre-release still excluded by default
+
+	try("x", New(7, 3, 1), true)
+
+	try("~1.2.3", New(1, 2, 3), true)
+	try("~1.2.3", New(1, 2, 9), true)
+	try("~1.2.3", New(1, 3, 0), false)
+
+	try("~1.2", New(1, 2, 0), true)
+	try("~1.2", New(1, 2, 9), true)
+	try("~1.2", New(1, 3, 0), false)
+
+	try("1.2.x", New(1, 2, 0), true)
+	try("1.2.x", New(1, 2, 9), true)
+	try("1.2.x", New(1, 3, 0), false)
+
+	try("1.x", New(1, 0, 0), true)
+	try("1.x", New(1, 9, 9), true)
+	try("1.x", New(2, 0, 0), false)
+
+	try(">=1.0.0 <2.0.0 || >=3.0.0", New(1, 5, 0), true)
+	try(">=1.0.0 <2.0.0 || >=3.0.0", New(2, 5, 0), false)
+	try(">=1.0.0 <2.0.0 || >=3.0.0", New(3, 0, 0), true)
+}
+
+func Test_ParseRange_preRelease(t *testing.T) {
+	// pre-release tags are excluded by default, even when a final version
+	// with the same major.minor.patch is in range
+	r, err := ParseRange(">=1.0.0 <2.0.0")
+	must.NoError(t, err)
+	must.False(t, r.Matches(New2(1, 5, 0, "alpha")))
+	must.True(t, r.Matches(New(1, 5, 0)))
+
+	// a pre-release tag matches if a comparator shares its major.minor.patch
+	// and carries a pre-release of its own
+	r, err = ParseRange(">=1.5.0-alpha <2.0.0")
+	must.NoError(t, err)
+	must.True(t, r.Matches(New2(1, 5, 0, "alpha")))
+	must.True(t, r.Matches(New2(1, 5, 0, "beta")))
+	must.False(t, r.Matches(New2(1, 4, 0, "alpha")))
+}
+
+func Test_ParseRange_errors(t *testing.T) {
+	_, err := ParseRange("")
+	must.Error(t, err)
+
+	_, err = ParseRange("not-a-version")
+	must.Error(t, err)
+}
+
+func Test_Range_String(t *testing.T) {
+	r, err := ParseRange(">=1.2.0 <2.0.0")
+	must.NoError(t, err)
+	must.Eq(t, ">=v1.2.0 <v2.0.0", r.String())
+
+	r, err = ParseRange(">=1.0.0 <2.0.0 || >=3.0.0")
+	must.NoError(t, err)
+	must.Eq(t, ">=v1.0.0 <v2.0.0 || >=v3.0.0", r.String())
+}
+
+func Test_Range_AND(t *testing.T) {
+	a, err := ParseRange(">=1.0.0")
+	must.NoError(t, err)
+	b, err := ParseRange("<2.0.0")
+	must.NoError(t, err)
+
+	combined := a.AND(b)
+	must.True(t, combined.Matches(New(1, 5, 0)))
+	must.False(t, combined.Matches(New(2, 0, 0)))
+	must.False(t, combined.Matches(New(0, 9, 0)))
+}
+
+func Test_Range_OR(t *testing.T) {
+	a, err := ParseRange("1.x")
+	must.NoError(t, err)
+	b, err := ParseRange("3.x")
+	must.NoError(t, err)
+
+	combined := a.OR(b)
+	must.True(t, combined.Matches(New(1, 5, 0)))
+	must.True(t, combined.Matches(New(3, 0, 0)))
+	must.False(t, combined.Matches(New(2, 0, 0)))
+}
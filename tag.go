@@ -22,6 +22,10 @@ var (
 	// The example regexp is available at
 	// https://semver.org/#is-there-a-suggested-regular-expression-regex-to-check-a-semver-string
 	semverRe = regexp.MustCompile(`^v(?P<major>0|[1-9]\d*)\.(?P<minor>0|[1-9]\d*)\.(?P<patch>0|[1-9]\d*)(?:-(?P<pr>(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+(?P<bm>[0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+	// looseRe is like semverRe, but permits the minor and patch components to
+	// be omitted entirely, to support shorthand like "v1" and "v1.2".
+	looseRe = regexp.MustCompile(`^v(?P<major>0|[1-9]\d*)(?:\.(?P<minor>0|[1-9]\d*))?(?:\.(?P<patch>0|[1-9]\d*))?(?:-(?P<pr>(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+(?P<bm>[0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
 )
 
 // New creates a new Tag with the most basic amount of information, which includes
@@ -125,6 +129,46 @@ func Parse(s string) (Tag, bool) {
 	}, true
 }
 
+// ParseLoose parses s as a Tag the same way Parse does, except the minor and
+// patch components may be omitted, with missing components treated as zero.
+// This matches the shorthand accepted by golang.org/x/mod/semver, and is
+// useful for consuming Go module query strings and human-entered constraints
+// where trailing zeros are commonly omitted.
+//
+// Examples:
+//
+//	v1       -> v1.0.0
+//	v1.2     -> v1.2.0
+//	v1-alpha -> v1.0.0-alpha
+//
+// Use Parse instead if the input must already be in canonical form.
+func ParseLoose(s string) (Tag, bool) {
+	matches := regexplus.FindNamedSubmatches(looseRe, s)
+
+	major, exists := matches["major"]
+	if !exists {
+		return empty, false
+	}
+
+	minor := 0
+	if m := matches["minor"]; m != "" {
+		minor = number(m)
+	}
+
+	patch := 0
+	if p := matches["patch"]; p != "" {
+		patch = number(p)
+	}
+
+	return Tag{
+		Major:         number(major),
+		Minor:         minor,
+		Patch:         patch,
+		PreRelease:    matches["pr"],
+		BuildMetadata: matches["bm"],
+	}, true
+}
+
 func number(s string) int {
 	n, err := strconv.Atoi(s)
 	if err != nil {
@@ -188,6 +232,63 @@ func (t Tag) IsBase() bool {
 	return t.PreRelease == ""
 }
 
+// BumpMajor returns a new Tag with Major incremented by one and Minor, Patch,
+// PreRelease, and BuildMetadata all reset, leaving t unmodified.
+func (t Tag) BumpMajor() Tag {
+	return New(t.Major+1, 0, 0)
+}
+
+// BumpMinor returns a new Tag with Minor incremented by one and Patch,
+// PreRelease, and BuildMetadata all reset, leaving t unmodified.
+func (t Tag) BumpMinor() Tag {
+	return New(t.Major, t.Minor+1, 0)
+}
+
+// BumpPatch returns a new Tag with Patch incremented by one and PreRelease
+// and BuildMetadata reset, leaving t unmodified.
+func (t Tag) BumpPatch() Tag {
+	return New(t.Major, t.Minor, t.Patch+1)
+}
+
+// WithPreRelease returns a new Tag with its PreRelease set to preRelease,
+// leaving t unmodified.
+func (t Tag) WithPreRelease(preRelease string) Tag {
+	return New3(t.Major, t.Minor, t.Patch, preRelease, t.BuildMetadata)
+}
+
+// WithBuildMetadata returns a new Tag with its BuildMetadata set to
+// buildMetadata, leaving t unmodified.
+func (t Tag) WithBuildMetadata(buildMetadata string) Tag {
+	return New3(t.Major, t.Minor, t.Patch, t.PreRelease, buildMetadata)
+}
+
+// NextPreRelease returns a new Tag with the trailing numeric identifier of
+// PreRelease incremented by one, e.g. "rc.1" becomes "rc.2". If the trailing
+// identifier is not numeric, a ".1" identifier is appended instead, e.g.
+// "alpha" becomes "alpha.1".
+//
+// NextPreRelease returns an error if t has no PreRelease identifier to
+// increment in the first place.
+func (t Tag) NextPreRelease() (Tag, error) {
+	if t.PreRelease == "" {
+		return empty, fmt.Errorf("tag %s has no pre-release identifier to increment", t)
+	}
+
+	identifiers := strings.Split(t.PreRelease, ".")
+	last := identifiers[len(identifiers)-1]
+	if isNumeric(last) {
+		n, err := strconv.Atoi(last)
+		if err != nil {
+			panic("bug in our numeric check")
+		}
+		identifiers[len(identifiers)-1] = strconv.Itoa(n + 1)
+	} else {
+		identifiers = append(identifiers, "1")
+	}
+
+	return t.WithPreRelease(strings.Join(identifiers, ".")), nil
+}
+
 func (t Tag) Less(o Tag) bool {
 	// build-metadata should be explicitly ignored for comparisons ; see https://semver.org/#spec-item-10
 	// pre-release is NOT ignored ; see https://semver.org/#spec-item-11
@@ -341,6 +442,23 @@ func isNumeric(s string) bool {
 	return numberRe.MatchString(s)
 }
 
+// Highest returns the maximum Tag in tags under semver ordering, without
+// requiring the caller to sort tags first. The second return value is false
+// if tags is empty.
+func Highest(tags []Tag) (Tag, bool) {
+	if len(tags) == 0 {
+		return empty, false
+	}
+
+	highest := tags[0]
+	for _, t := range tags[1:] {
+		if highest.Less(t) {
+			highest = t
+		}
+	}
+	return highest, true
+}
+
 type BySemver []Tag
 
 func (tags BySemver) Len() int      { return len(tags) }
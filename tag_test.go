@@ -72,6 +72,23 @@ func Test_Parse(t *testing.T) {
 	try("v2.0.0-pre+incompatible", New3(2, 0, 0, "pre", "incompatible"), true)
 }
 
+func Test_ParseLoose(t *testing.T) {
+	try := func(s string, exp Tag, expOK bool) {
+		result, ok := ParseLoose(s)
+		must.Eq(t, expOK, ok)
+		must.Eq(t, exp, result)
+	}
+
+	try("v1", New(1, 0, 0), true)
+	try("v1.2", New(1, 2, 0), true)
+	try("v1.2.3", New(1, 2, 3), true)
+	try("v1-alpha", New2(1, 0, 0, "alpha"), true)
+	try("v1.2-alpha", New2(1, 2, 0, "alpha"), true)
+	try("v1.2.3-alpha+linux", New3(1, 2, 3, "alpha", "linux"), true)
+	try("1.2", empty, false) // missing v
+	try("v1.2.3.4", empty, false)
+}
+
 func Test_String(t *testing.T) {
 	must.Eq(t, "v1.2.3", New(1, 2, 3).String())
 	must.Eq(t, "v0.8.2-0.20190227000051-27936f6d90f9", New2(0, 8, 2, "0.20190227000051-27936f6d90f9").String())
@@ -185,6 +202,54 @@ func Test_Sort_BySemver_preReleases(t *testing.T) {
 	must.Eq(t, expected, tags)
 }
 
+func Test_Tag_Bump(t *testing.T) {
+	tag := New3(1, 2, 3, "rc1", "linux")
+
+	must.Eq(t, New(2, 0, 0), tag.BumpMajor())
+	must.Eq(t, New(1, 3, 0), tag.BumpMinor())
+	must.Eq(t, New(1, 2, 4), tag.BumpPatch())
+
+	// receiver is left unmodified
+	must.Eq(t, New3(1, 2, 3, "rc1", "linux"), tag)
+}
+
+func Test_Tag_With(t *testing.T) {
+	tag := New(1, 2, 3)
+
+	must.Eq(t, New2(1, 2, 3, "alpha"), tag.WithPreRelease("alpha"))
+	must.Eq(t, New4(1, 2, 3, "linux"), tag.WithBuildMetadata("linux"))
+}
+
+func Test_Tag_NextPreRelease(t *testing.T) {
+	try := func(pre string, expPre string) {
+		tag := New2(1, 2, 3, pre)
+		result, err := tag.NextPreRelease()
+		must.NoError(t, err)
+		must.Eq(t, New2(1, 2, 3, expPre), result)
+	}
+
+	try("rc.1", "rc.2")
+	try("alpha", "alpha.1")
+	try("alpha.9", "alpha.10")
+
+	_, err := New(1, 2, 3).NextPreRelease()
+	must.Error(t, err)
+}
+
+func Test_Highest(t *testing.T) {
+	tags := []Tag{
+		New(1, 2, 3),
+		New(3, 0, 0),
+		New(2, 5, 1),
+	}
+	result, ok := Highest(tags)
+	must.True(t, ok)
+	must.Eq(t, New(3, 0, 0), result)
+
+	_, ok = Highest(nil)
+	must.False(t, ok)
+}
+
 func load(t *testing.T, filename string) []Tag {
 	f, err := os.Open(filename)
 	must.NoError(t, err)